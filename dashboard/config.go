@@ -0,0 +1,61 @@
+package dashboard
+
+import "sync"
+
+// SiteConfig is the live-editable subset of a site's scrape settings.
+// It does not replace the YAML rule files (selectors, regexes) — only
+// the knobs operators reasonably want to tweak at runtime.
+type SiteConfig struct {
+	Source        string `json:"source"`
+	PagesToScrape int    `json:"pagesToScrape"`
+}
+
+// Config holds the mutable, dashboard-editable scrape configuration.
+// Scraper goroutines read it on each page-loop iteration so edits take
+// effect without a restart.
+type Config struct {
+	mu    sync.RWMutex
+	sites map[string]*SiteConfig
+}
+
+// NewConfig seeds a Config from the sites known at startup.
+func NewConfig(sites []SiteConfig) *Config {
+	c := &Config{sites: make(map[string]*SiteConfig, len(sites))}
+	for _, s := range sites {
+		sc := s
+		c.sites[s.Source] = &sc
+	}
+	return c
+}
+
+// PagesToScrape returns the current page limit for source, and whether
+// it has an override at all.
+func (c *Config) PagesToScrape(source string) (int, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s, ok := c.sites[source]
+	if !ok {
+		return 0, false
+	}
+	return s.PagesToScrape, true
+}
+
+// Snapshot returns every site's current configuration.
+func (c *Config) Snapshot() []SiteConfig {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	out := make([]SiteConfig, 0, len(c.sites))
+	for _, s := range c.sites {
+		out = append(out, *s)
+	}
+	return out
+}
+
+// Update applies an edit to source's configuration, creating it if it
+// doesn't already exist (e.g. a site added without a restart).
+func (c *Config) Update(sc SiteConfig) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	updated := sc
+	c.sites[sc.Source] = &updated
+}
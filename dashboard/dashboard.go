@@ -0,0 +1,189 @@
+// Package dashboard exposes a small embedded HTTP UI and JSON API for
+// observing and controlling a running scrape: pausing/resuming
+// per-source worker pools, live-editing pagination limits, and
+// streaming recently scraped properties.
+package dashboard
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Prashidha0O1/real_estates_insights/pool"
+	"github.com/Prashidha0O1/real_estates_insights/property"
+)
+
+// Dashboard is an embedded control surface for a scrape run.
+type Dashboard struct {
+	pools  *pool.Manager
+	config *Config
+	feed   *feed
+}
+
+// New builds a Dashboard backed by the given pool manager and config.
+func New(pools *pool.Manager, config *Config) *Dashboard {
+	return &Dashboard{pools: pools, config: config, feed: newFeed()}
+}
+
+// Publish records a newly scraped property for the live feed endpoint.
+func (d *Dashboard) Publish(p property.Property) { d.feed.Publish(p) }
+
+// Handler returns the dashboard's http.Handler: the single-page UI plus
+// its JSON/SSE API.
+func (d *Dashboard) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", d.handleIndex)
+	mux.HandleFunc("/api/status", d.handleStatus)
+	mux.HandleFunc("/api/config", d.handleConfig)
+	mux.HandleFunc("/api/feed", d.handleFeed)
+	mux.HandleFunc("/api/pools/", d.handlePoolAction)
+	return mux
+}
+
+// ListenAndServe starts the dashboard on addr. It blocks until the
+// server stops or errors.
+func (d *Dashboard) ListenAndServe(addr string) error {
+	return http.ListenAndServe(addr, d.Handler())
+}
+
+func (d *Dashboard) handleIndex(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(indexHTML))
+}
+
+func (d *Dashboard) handleStatus(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, d.pools.Snapshot())
+}
+
+func (d *Dashboard) handleConfig(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, d.config.Snapshot())
+	case http.MethodPut, http.MethodPost:
+		var sc SiteConfig
+		if err := json.NewDecoder(r.Body).Decode(&sc); err != nil {
+			http.Error(w, fmt.Sprintf("invalid config payload: %v", err), http.StatusBadRequest)
+			return
+		}
+		d.config.Update(sc)
+		writeJSON(w, d.config.Snapshot())
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handlePoolAction routes /api/pools/{source}/pause and
+// /api/pools/{source}/resume.
+func (d *Dashboard) handlePoolAction(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/pools/"), "/")
+	if len(parts) != 2 {
+		http.NotFound(w, r)
+		return
+	}
+	source, action := parts[0], parts[1]
+
+	p, ok := d.pools.Get(source)
+	if !ok {
+		http.Error(w, fmt.Sprintf("unknown source %q", source), http.StatusNotFound)
+		return
+	}
+
+	switch action {
+	case "pause":
+		p.Pause()
+	case "resume":
+		p.Resume()
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	writeJSON(w, map[string]string{"source": source, "action": action})
+}
+
+// handleFeed streams recently scraped properties as server-sent
+// events: the current backlog first, then one event per new arrival.
+func (d *Dashboard) handleFeed(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	backlog, updates, unsubscribe := d.feed.Subscribe()
+	defer unsubscribe()
+
+	for _, p := range backlog {
+		writeSSE(w, p)
+	}
+	flusher.Flush()
+
+	for {
+		select {
+		case p, ok := <-updates:
+			if !ok {
+				return
+			}
+			writeSSE(w, p)
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		case <-time.After(30 * time.Second):
+			fmt.Fprint(w, ": keepalive\n\n")
+			flusher.Flush()
+		}
+	}
+}
+
+func writeSSE(w http.ResponseWriter, p property.Property) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(w, "data: %s\n\n", data)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+const indexHTML = `<!DOCTYPE html>
+<html>
+<head><title>Real Estate Insights — Scraper Dashboard</title></head>
+<body>
+<h1>Scraper Dashboard</h1>
+<pre id="status">loading...</pre>
+<ul id="feed"></ul>
+<script>
+async function refreshStatus() {
+  const res = await fetch('/api/status');
+  document.getElementById('status').textContent = JSON.stringify(await res.json(), null, 2);
+}
+setInterval(refreshStatus, 2000);
+refreshStatus();
+
+const feed = document.getElementById('feed');
+const source = new EventSource('/api/feed');
+source.onmessage = (event) => {
+  const p = JSON.parse(event.data);
+  const li = document.createElement('li');
+  li.textContent = p.source + ': ' + p.title + ' — ' + p.price + ' ' + p.currency;
+  feed.prepend(li);
+  while (feed.children.length > 50) feed.removeChild(feed.lastChild);
+};
+</script>
+</body>
+</html>`
@@ -0,0 +1,70 @@
+package dashboard
+
+import (
+	"sync"
+
+	"github.com/Prashidha0O1/real_estates_insights/property"
+)
+
+// feedCapacity is the number of recently scraped properties kept
+// in-memory for new dashboard clients to catch up on.
+const feedCapacity = 50
+
+// feed is a small ring buffer of recent properties plus a set of live
+// subscribers, used to back the dashboard's server-sent events stream.
+type feed struct {
+	mu          sync.Mutex
+	recent      []property.Property
+	subscribers map[chan property.Property]struct{}
+}
+
+func newFeed() *feed {
+	return &feed{subscribers: make(map[chan property.Property]struct{})}
+}
+
+// Publish records p as the most recent property and fans it out to any
+// subscribed dashboard clients. Slow subscribers are dropped rather
+// than blocking the scraper.
+func (f *feed) Publish(p property.Property) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.recent = append(f.recent, p)
+	if len(f.recent) > feedCapacity {
+		f.recent = f.recent[len(f.recent)-feedCapacity:]
+	}
+
+	for ch := range f.subscribers {
+		select {
+		case ch <- p:
+		default:
+			delete(f.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// Subscribe returns the recent backlog plus a channel that receives
+// every property published from now on. Call the returned function to
+// unsubscribe.
+func (f *feed) Subscribe() ([]property.Property, chan property.Property, func()) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	backlog := make([]property.Property, len(f.recent))
+	copy(backlog, f.recent)
+
+	ch := make(chan property.Property, feedCapacity)
+	f.subscribers[ch] = struct{}{}
+
+	unsubscribe := func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if _, ok := f.subscribers[ch]; ok {
+			delete(f.subscribers, ch)
+			close(ch)
+		}
+	}
+
+	return backlog, ch, unsubscribe
+}
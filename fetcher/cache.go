@@ -0,0 +1,49 @@
+package fetcher
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+)
+
+// diskCache stores raw HTML responses on disk keyed by a hash of the
+// request URL, so repeated runs during development don't re-hit origins.
+type diskCache struct {
+	dir string
+}
+
+func newDiskCache(dir string) (*diskCache, error) {
+	if dir == "" {
+		return nil, nil
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &diskCache{dir: dir}, nil
+}
+
+func (c *diskCache) path(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".html")
+}
+
+// Get returns the cached body for url, if present.
+func (c *diskCache) Get(url string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	data, err := os.ReadFile(c.path(url))
+	if err != nil {
+		return "", false
+	}
+	return string(data), true
+}
+
+// Put stores body under url's cache key.
+func (c *diskCache) Put(url, body string) error {
+	if c == nil {
+		return nil
+	}
+	return os.WriteFile(c.path(url), []byte(body), 0o644)
+}
@@ -0,0 +1,220 @@
+// Package fetcher provides a shared, polite HTTP client for the
+// scraper: it honors robots.txt and crawl-delay, rate-limits per host,
+// retries transient failures with backoff, and caches responses on
+// disk so repeated runs don't re-hit origins.
+package fetcher
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/Prashidha0O1/real_estates_insights/render"
+)
+
+const defaultUserAgent = "real-estates-insights-bot/1.0 (+https://github.com/Prashidha0O1/real_estates_insights)"
+
+// Config configures a Fetcher.
+type Config struct {
+	UserAgent      string
+	MaxRetries     int           // default 3
+	MinHostDelay   time.Duration // floor on per-host request spacing; robots.txt crawl-delay can raise it
+	CacheDir       string        // empty disables the disk cache
+	ProxyURL       string        // empty uses the environment's default proxy behavior
+	InsecureTLS    bool          // skip TLS certificate verification (self-signed/dev endpoints only)
+	RequestTimeout time.Duration // default 15s
+	Renderer       render.Renderer // optional; required only for adapters with RequiresJS
+}
+
+// Fetcher is a shared, polite HTTP client used by every site adapter.
+type Fetcher struct {
+	client  *http.Client
+	cfg     Config
+	cache   *diskCache
+	robots  *robotsCache
+	metrics *Metrics
+
+	mu      sync.Mutex
+	lastHit map[string]time.Time // host -> last request time, for rate limiting
+}
+
+// New builds a Fetcher from cfg, applying sane defaults for zero values.
+func New(cfg Config) (*Fetcher, error) {
+	if cfg.UserAgent == "" {
+		cfg.UserAgent = defaultUserAgent
+	}
+	if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = 3
+	}
+	if cfg.RequestTimeout == 0 {
+		cfg.RequestTimeout = 15 * time.Second
+	}
+
+	transport := &http.Transport{}
+	if cfg.InsecureTLS {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	if cfg.ProxyURL != "" {
+		proxyURL, err := url.Parse(cfg.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("fetcher: bad proxy URL: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	client := &http.Client{Transport: transport, Timeout: cfg.RequestTimeout}
+
+	cache, err := newDiskCache(cfg.CacheDir)
+	if err != nil {
+		return nil, fmt.Errorf("fetcher: setting up cache dir: %w", err)
+	}
+
+	return &Fetcher{
+		client:  client,
+		cfg:     cfg,
+		cache:   cache,
+		robots:  newRobotsCache(client, cfg.UserAgent),
+		metrics: NewMetrics(),
+		lastHit: make(map[string]time.Time),
+	}, nil
+}
+
+// Metrics returns the shared metrics tracker so callers can report
+// requests/cache hits/retries/failures per source.
+func (f *Fetcher) Metrics() *Metrics { return f.metrics }
+
+// Result is the outcome of a single fetch: the page body and the URL it
+// was ultimately served from, which can differ from the requested URL
+// after a redirect.
+type Result struct {
+	Body     string
+	FinalURL string
+}
+
+// FetchPage fetches rawURL via the plain HTTP path or, when requiresJS
+// is set, through the configured Renderer, and reports the final URL
+// (after redirects) alongside the body for structured result reporting.
+func (f *Fetcher) FetchPage(ctx context.Context, rawURL, source string, requiresJS bool, opts render.Options) (Result, error) {
+	if !requiresJS {
+		return f.fetch(ctx, rawURL, source, f.doGet)
+	}
+	if f.cfg.Renderer == nil {
+		return Result{}, fmt.Errorf("fetcher: %s requires JS rendering but no Renderer is configured", rawURL)
+	}
+	return f.fetch(ctx, rawURL, source, func(u string) (Result, error) {
+		html, err := f.cfg.Renderer.Render(ctx, u, opts)
+		return Result{Body: html, FinalURL: u}, err
+	})
+}
+
+func (f *Fetcher) fetch(ctx context.Context, rawURL, source string, doFetch func(string) (Result, error)) (Result, error) {
+	if body, ok := f.cache.Get(rawURL); ok {
+		f.metrics.incCacheHits(source)
+		return Result{Body: body, FinalURL: rawURL}, nil
+	}
+
+	rules := f.robots.rulesFor(rawURL)
+	if !rules.allows(pathOf(rawURL)) {
+		return Result{}, fmt.Errorf("fetcher: %s disallowed by robots.txt", rawURL)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= f.cfg.MaxRetries; attempt++ {
+		if attempt > 0 {
+			f.metrics.incRetries(source)
+			time.Sleep(backoff(attempt))
+		}
+		if ctx.Err() != nil {
+			return Result{}, ctx.Err()
+		}
+		f.waitForHost(rawURL, rules.crawlDelay)
+
+		f.metrics.incRequests(source)
+		res, err := doFetch(rawURL)
+		if err == nil {
+			if putErr := f.cache.Put(rawURL, res.Body); putErr != nil {
+				return res, fmt.Errorf("fetcher: caching %s: %w", rawURL, putErr)
+			}
+			return res, nil
+		}
+		lastErr = err
+	}
+
+	f.metrics.incFailures(source)
+	return Result{}, fmt.Errorf("fetcher: giving up on %s after %d attempts: %w", rawURL, f.cfg.MaxRetries+1, lastErr)
+}
+
+func (f *Fetcher) doGet(rawURL string) (Result, error) {
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		return Result{}, err
+	}
+	req.Header.Set("User-Agent", f.cfg.UserAgent)
+
+	res, err := f.client.Do(req)
+	if err != nil {
+		return Result{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return Result{}, fmt.Errorf("non-200 status: %d %s", res.StatusCode, res.Status)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return Result{}, err
+	}
+
+	finalURL := rawURL
+	if res.Request != nil && res.Request.URL != nil {
+		finalURL = res.Request.URL.String()
+	}
+
+	return Result{Body: string(body), FinalURL: finalURL}, nil
+}
+
+// waitForHost blocks until MinHostDelay (or crawlDelay, whichever is
+// larger) has elapsed since the last request to rawURL's host.
+func (f *Fetcher) waitForHost(rawURL string, crawlDelay time.Duration) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return
+	}
+
+	delay := f.cfg.MinHostDelay
+	if crawlDelay > delay {
+		delay = crawlDelay
+	}
+	if delay <= 0 {
+		return
+	}
+
+	// Reserve the next slot under the lock so concurrent callers for the
+	// same host are spaced out from each other, not just from the past.
+	f.mu.Lock()
+	next := time.Now()
+	if last, ok := f.lastHit[u.Host]; ok && last.Add(delay).After(next) {
+		next = last.Add(delay)
+	}
+	f.lastHit[u.Host] = next
+	f.mu.Unlock()
+
+	if wait := time.Until(next); wait > 0 {
+		time.Sleep(wait)
+	}
+}
+
+// backoff returns an exponential backoff duration with jitter for the
+// given (1-indexed) retry attempt.
+func backoff(attempt int) time.Duration {
+	base := time.Duration(1<<uint(attempt-1)) * 500 * time.Millisecond
+	jitter := time.Duration(rand.Int63n(int64(base) / 2))
+	return base + jitter
+}
@@ -0,0 +1,67 @@
+package fetcher
+
+import "sync"
+
+// Metrics tracks request outcomes per source so operators can see which
+// sites are healthy without grepping logs.
+type Metrics struct {
+	mu      sync.Mutex
+	sources map[string]*SourceMetrics
+}
+
+// SourceMetrics is a snapshot of counters for a single source.
+type SourceMetrics struct {
+	Requests  int
+	CacheHits int
+	Retries   int
+	Failures  int
+}
+
+// NewMetrics returns an empty Metrics tracker.
+func NewMetrics() *Metrics {
+	return &Metrics{sources: make(map[string]*SourceMetrics)}
+}
+
+func (m *Metrics) incRequests(source string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entryLocked(source).Requests++
+}
+
+func (m *Metrics) incCacheHits(source string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entryLocked(source).CacheHits++
+}
+
+func (m *Metrics) incRetries(source string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entryLocked(source).Retries++
+}
+
+func (m *Metrics) incFailures(source string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entryLocked(source).Failures++
+}
+
+func (m *Metrics) entryLocked(source string) *SourceMetrics {
+	s, ok := m.sources[source]
+	if !ok {
+		s = &SourceMetrics{}
+		m.sources[source] = s
+	}
+	return s
+}
+
+// Snapshot returns a copy of the per-source counters collected so far.
+func (m *Metrics) Snapshot() map[string]SourceMetrics {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make(map[string]SourceMetrics, len(m.sources))
+	for source, s := range m.sources {
+		out[source] = *s
+	}
+	return out
+}
@@ -0,0 +1,160 @@
+package fetcher
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// robotsRules is the subset of a robots.txt we care about: the disallow
+// prefixes that apply to our User-Agent (or "*"), and the crawl-delay.
+type robotsRules struct {
+	disallow   []string
+	crawlDelay time.Duration
+}
+
+func (r robotsRules) allows(path string) bool {
+	for _, prefix := range r.disallow {
+		if prefix != "" && strings.HasPrefix(path, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// robotsCache fetches and memoizes robots.txt per host.
+type robotsCache struct {
+	mu     sync.Mutex
+	client *http.Client
+	ua     string
+	rules  map[string]robotsRules
+}
+
+func newRobotsCache(client *http.Client, userAgent string) *robotsCache {
+	return &robotsCache{client: client, ua: userAgent, rules: make(map[string]robotsRules)}
+}
+
+func (rc *robotsCache) rulesFor(rawURL string) robotsRules {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return robotsRules{}
+	}
+	host := u.Scheme + "://" + u.Host
+
+	rc.mu.Lock()
+	if rules, ok := rc.rules[host]; ok {
+		rc.mu.Unlock()
+		return rules
+	}
+	rc.mu.Unlock()
+
+	rules := rc.fetch(host)
+
+	rc.mu.Lock()
+	rc.rules[host] = rules
+	rc.mu.Unlock()
+
+	return rules
+}
+
+func (rc *robotsCache) fetch(host string) robotsRules {
+	req, err := http.NewRequest(http.MethodGet, host+"/robots.txt", nil)
+	if err != nil {
+		return robotsRules{}
+	}
+	req.Header.Set("User-Agent", rc.ua)
+
+	res, err := rc.client.Do(req)
+	if err != nil {
+		return robotsRules{}
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return robotsRules{}
+	}
+
+	return parseRobots(res.Body, rc.ua)
+}
+
+// parseRobots implements just enough of the robots.txt spec for our
+// purposes: it honors the "*" group and any group naming our own
+// User-Agent product token, collecting Disallow prefixes and
+// Crawl-delay. Per the spec, consecutive "User-agent:" lines form one
+// group that applies if any of its agents match, so a block like
+// "User-agent: *" followed by "User-agent: Googlebot" before the
+// directives still applies to us via the wildcard.
+func parseRobots(body io.Reader, userAgent string) robotsRules {
+	ourToken := productToken(userAgent)
+	scanner := bufio.NewScanner(body)
+	var rules robotsRules
+	appliesToUs := false
+	inAgentBlock := false
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(parts[0]))
+		value := strings.TrimSpace(parts[1])
+
+		if key == "user-agent" {
+			if !inAgentBlock {
+				appliesToUs = false
+				inAgentBlock = true
+			}
+			if value == "*" || strings.EqualFold(value, ourToken) {
+				appliesToUs = true
+			}
+			continue
+		}
+		inAgentBlock = false
+
+		switch key {
+		case "disallow":
+			if appliesToUs && value != "" {
+				rules.disallow = append(rules.disallow, value)
+			}
+		case "crawl-delay":
+			if appliesToUs {
+				if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+					rules.crawlDelay = time.Duration(seconds * float64(time.Second))
+				}
+			}
+		}
+	}
+
+	return rules
+}
+
+// productToken extracts the bot name a robots.txt "User-agent:" line
+// would name us by, e.g. "real-estates-insights-bot" out of
+// "real-estates-insights-bot/1.0 (+https://...)".
+func productToken(userAgent string) string {
+	token := userAgent
+	if i := strings.IndexAny(token, "/ "); i >= 0 {
+		token = token[:i]
+	}
+	return token
+}
+
+func pathOf(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	if u.RawQuery != "" {
+		return fmt.Sprintf("%s?%s", u.Path, u.RawQuery)
+	}
+	return u.Path
+}
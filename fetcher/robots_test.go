@@ -0,0 +1,47 @@
+package fetcher
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseRobots(t *testing.T) {
+	const body = `
+User-agent: *
+Disallow: /private
+Crawl-delay: 2
+
+User-agent: Googlebot
+User-agent: real-estates-insights-bot
+Disallow: /named-only
+`
+
+	rules := parseRobots(strings.NewReader(body), "real-estates-insights-bot/1.0 (+https://example.com)")
+
+	if rules.allows("/private/x") {
+		t.Error("expected /private to be disallowed by the wildcard group")
+	}
+	if !rules.allows("/public") {
+		t.Error("expected /public to be allowed")
+	}
+	if rules.allows("/named-only") {
+		t.Error("expected /named-only to be disallowed by the group naming us")
+	}
+	if rules.crawlDelay.Seconds() != 2 {
+		t.Errorf("unexpected crawl delay: %v", rules.crawlDelay)
+	}
+}
+
+func TestParseRobotsWildcardGroupAppliesAfterOtherNamedAgent(t *testing.T) {
+	const body = `
+User-agent: *
+User-agent: Googlebot
+Disallow: /shared
+`
+
+	rules := parseRobots(strings.NewReader(body), "real-estates-insights-bot/1.0")
+
+	if rules.allows("/shared/x") {
+		t.Error("expected the wildcard group to still apply even though Googlebot was named alongside it")
+	}
+}
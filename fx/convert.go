@@ -0,0 +1,44 @@
+package fx
+
+import (
+	"log"
+
+	"github.com/Prashidha0O1/real_estates_insights/property"
+)
+
+// Converter augments properties with a resolved ISO-4217 currency and a
+// USD price, using historical rates as of the listing's ScrapedAt date.
+type Converter struct {
+	provider Provider
+}
+
+// NewConverter returns a Converter backed by provider.
+func NewConverter(provider Provider) *Converter {
+	return &Converter{provider: provider}
+}
+
+// Augment resolves p.Currency to ISO-4217, fills in PriceLocal,
+// PriceUSD, FXRate, and FXDate, and returns the updated property. If the
+// currency can't be resolved or no rate is available, PriceUSD is left
+// at 0 and the failure is logged rather than dropping the listing.
+func (c *Converter) Augment(p property.Property) property.Property {
+	p.PriceLocal = p.Price
+	p.FXDate = p.ScrapedAt
+
+	code, ok := ResolveSymbol(p.Currency, p.Source)
+	if !ok {
+		log.Printf("fx: could not resolve currency %q for %s, leaving PriceUSD unset", p.Currency, p.URL)
+		return p
+	}
+	p.Currency = code
+
+	rate, err := c.provider.Rate(code, p.ScrapedAt)
+	if err != nil {
+		log.Printf("fx: %v, leaving PriceUSD unset for %s", err, p.URL)
+		return p
+	}
+
+	p.FXRate = rate
+	p.PriceUSD = p.PriceLocal / rate
+	return p
+}
@@ -0,0 +1,77 @@
+package fx
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Prashidha0O1/real_estates_insights/property"
+)
+
+const testCSV = `2024-01-01,NPR,133.5
+2024-01-01,AED,3.67
+2024-01-01,AUD,1.47
+`
+
+func newTestProvider(t *testing.T) *CSVProvider {
+	t.Helper()
+	p, err := NewCSVProviderFromReader(strings.NewReader(testCSV))
+	if err != nil {
+		t.Fatalf("NewCSVProviderFromReader: %v", err)
+	}
+	return p
+}
+
+func TestConverterAugmentKnownCurrencies(t *testing.T) {
+	converter := NewConverter(newTestProvider(t))
+	scrapedAt := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name         string
+		currency     string
+		source       string
+		price        float64
+		wantCurrency string
+		wantUSD      float64
+	}{
+		{"NPR", "Rs", "realestateinnepal.com", 133500, "NPR", 1000},
+		{"AED", "AED", "realestate.com.au/dubai", 3670, "AED", 1000},
+		{"AUD", "AUD", "realestate.com.au/dubai", 1470, "AUD", 1000},
+		{"USD passthrough", "USD", "realestate.com.au/dubai", 1000, "USD", 1000},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := property.Property{Price: tc.price, Currency: tc.currency, Source: tc.source, ScrapedAt: scrapedAt}
+			got := converter.Augment(p)
+
+			if got.Currency != tc.wantCurrency {
+				t.Errorf("Currency = %q, want %q", got.Currency, tc.wantCurrency)
+			}
+			if diff := got.PriceUSD - tc.wantUSD; diff > 0.01 || diff < -0.01 {
+				t.Errorf("PriceUSD = %v, want ~%v", got.PriceUSD, tc.wantUSD)
+			}
+			if got.PriceLocal != tc.price {
+				t.Errorf("PriceLocal = %v, want %v", got.PriceLocal, tc.price)
+			}
+		})
+	}
+}
+
+func TestConverterAugmentUnresolvableCurrencyFallsBackToUnset(t *testing.T) {
+	converter := NewConverter(newTestProvider(t))
+	p := property.Property{
+		Price:     500,
+		Currency:  "XYZ",
+		Source:    "unknown-source",
+		ScrapedAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	got := converter.Augment(p)
+	if got.PriceUSD != 0 {
+		t.Errorf("expected PriceUSD to stay unset for unresolvable currency, got %v", got.PriceUSD)
+	}
+	if got.PriceLocal != 500 {
+		t.Errorf("PriceLocal = %v, want 500", got.PriceLocal)
+	}
+}
@@ -0,0 +1,95 @@
+package fx
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+)
+
+// dailyRate is one (date, rate) sample for a currency.
+type dailyRate struct {
+	date time.Time
+	rate float64
+}
+
+// CSVProvider serves historical rates from a local CSV cache with
+// columns "date,currency,rate" (date as YYYY-MM-DD, rate as units of
+// currency per 1 USD). It's the cheapest provider to run offline or in
+// tests.
+type CSVProvider struct {
+	rates map[string][]dailyRate // currency -> samples sorted by date ascending
+}
+
+// LoadCSVProvider reads path into memory. Rows are expected in the
+// format parsed by NewCSVProviderFromReader.
+func LoadCSVProvider(path string) (*CSVProvider, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("fx: opening %s: %w", path, err)
+	}
+	defer f.Close()
+	return NewCSVProviderFromReader(f)
+}
+
+// NewCSVProviderFromReader parses CSV rows of "date,currency,rate".
+func NewCSVProviderFromReader(r io.Reader) (*CSVProvider, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+
+	rates := make(map[string][]dailyRate)
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("fx: parsing CSV row: %w", err)
+		}
+
+		date, err := time.Parse("2006-01-02", row[0])
+		if err != nil {
+			continue // tolerate a header row
+		}
+		currency := row[1]
+		var rate float64
+		if _, err := fmt.Sscanf(row[2], "%f", &rate); err != nil {
+			continue
+		}
+
+		rates[currency] = append(rates[currency], dailyRate{date: date, rate: rate})
+	}
+
+	for currency := range rates {
+		sort.Slice(rates[currency], func(i, j int) bool {
+			return rates[currency][i].date.Before(rates[currency][j].date)
+		})
+	}
+
+	return &CSVProvider{rates: rates}, nil
+}
+
+// Rate returns the sample for currency on the latest date on or before
+// the requested date (falling back to the earliest known sample if the
+// requested date predates all of them).
+func (p *CSVProvider) Rate(currency string, date time.Time) (float64, error) {
+	if currency == "USD" {
+		return USDRate, nil
+	}
+
+	samples, ok := p.rates[currency]
+	if !ok || len(samples) == 0 {
+		return 0, &ErrNoRate{Currency: currency, Date: date}
+	}
+
+	best := samples[0]
+	for _, s := range samples {
+		if s.date.After(date) {
+			break
+		}
+		best = s
+	}
+	return best.rate, nil
+}
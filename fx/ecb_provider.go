@@ -0,0 +1,115 @@
+package fx
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ecbHistoricalURL is the ECB's "last 90 days" daily reference rates
+// feed. Rates are EUR-based; Rate() rebases them to USD.
+const ecbHistoricalURL = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-hist-90d.xml"
+
+// ecbEnvelope mirrors the ECB's eurofxref-hist XML schema:
+// Cube[date] > Cube[currency=rate], rates expressed per 1 EUR.
+type ecbEnvelope struct {
+	Cubes []ecbDateCube `xml:"Cube>Cube"`
+}
+
+type ecbDateCube struct {
+	Date  string        `xml:"time,attr"`
+	Rates []ecbRateCube `xml:"Cube"`
+}
+
+type ecbRateCube struct {
+	Currency string  `xml:"currency,attr"`
+	Rate     float64 `xml:"rate,attr"`
+}
+
+// ECBProvider fetches the European Central Bank's daily reference
+// rates and rebases them from EUR to USD.
+type ECBProvider struct {
+	client *http.Client
+	url    string
+
+	// byDate[date][currency] = units of currency per 1 EUR, as published.
+	byDate map[string]map[string]float64
+}
+
+// NewECBProvider returns a provider that will fetch rates on first use.
+// Pass a custom client for tests/proxies; nil uses http.DefaultClient.
+func NewECBProvider(client *http.Client) *ECBProvider {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &ECBProvider{client: client, url: ecbHistoricalURL}
+}
+
+// Refresh re-downloads and re-parses the ECB feed. Rate() calls this
+// automatically the first time it's used, but callers running a
+// long-lived process should call it periodically (the feed only covers
+// the last 90 days).
+func (p *ECBProvider) Refresh() error {
+	req, err := http.NewRequest(http.MethodGet, p.url, nil)
+	if err != nil {
+		return fmt.Errorf("fx: building ECB request: %w", err)
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("fx: fetching ECB rates: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("fx: ECB feed returned %s", res.Status)
+	}
+
+	var envelope ecbEnvelope
+	if err := xml.NewDecoder(res.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("fx: parsing ECB feed: %w", err)
+	}
+
+	byDate := make(map[string]map[string]float64, len(envelope.Cubes))
+	for _, day := range envelope.Cubes {
+		rates := make(map[string]float64, len(day.Rates))
+		for _, r := range day.Rates {
+			rates[r.Currency] = r.Rate
+		}
+		rates["EUR"] = 1.0
+		byDate[day.Date] = rates
+	}
+	p.byDate = byDate
+
+	return nil
+}
+
+// Rate returns currency's units-per-USD rate on date, rebasing the
+// EUR-denominated ECB feed (rate = currency-per-EUR / USD-per-EUR).
+func (p *ECBProvider) Rate(currency string, date time.Time) (float64, error) {
+	if currency == "USD" {
+		return USDRate, nil
+	}
+
+	if p.byDate == nil {
+		if err := p.Refresh(); err != nil {
+			return 0, err
+		}
+	}
+
+	day, ok := p.byDate[date.Format("2006-01-02")]
+	if !ok {
+		return 0, &ErrNoRate{Currency: currency, Date: date}
+	}
+
+	usdPerEUR, ok := day["USD"]
+	if !ok {
+		return 0, &ErrNoRate{Currency: "USD", Date: date}
+	}
+	currencyPerEUR, ok := day[currency]
+	if !ok {
+		return 0, &ErrNoRate{Currency: currency, Date: date}
+	}
+
+	return currencyPerEUR / usdPerEUR, nil
+}
@@ -0,0 +1,29 @@
+// Package fx resolves listing currencies to ISO-4217 codes and converts
+// local-currency prices to USD using historical daily exchange rates, so
+// downstream analytics can compare listings across markets.
+package fx
+
+import (
+	"fmt"
+	"time"
+)
+
+// Provider supplies the exchange rate for one currency on one date, as
+// units of currency per 1 USD (e.g. Rate("NPR", d) ~= 133.5).
+type Provider interface {
+	Rate(currency string, date time.Time) (float64, error)
+}
+
+// ErrNoRate is returned by a Provider when no rate is available for the
+// requested currency/date, even after its own fallback logic.
+type ErrNoRate struct {
+	Currency string
+	Date     time.Time
+}
+
+func (e *ErrNoRate) Error() string {
+	return fmt.Sprintf("fx: no rate for %s on %s", e.Currency, e.Date.Format("2006-01-02"))
+}
+
+// USDRate is the trivial identity rate: USD is always 1 unit per USD.
+const USDRate = 1.0
@@ -0,0 +1,54 @@
+package fx
+
+import "strings"
+
+// unambiguousSymbols maps symbols and codes that mean exactly one
+// ISO-4217 currency regardless of context.
+var unambiguousSymbols = map[string]string{
+	"AED": "AED",
+	"AUD": "AUD",
+	"NPR": "NPR",
+	"RS":  "NPR", // realestateinnepal.com lists prices as "Rs"
+	"USD": "USD",
+	"GBP": "GBP",
+	"£":   "GBP",
+	"€":   "EUR",
+	"EUR": "EUR",
+}
+
+// sourceDefaults resolves ambiguous symbols like "$" using the
+// Property.Source the listing came from, since the same glyph means a
+// different currency on different sites.
+var sourceDefaults = map[string]string{
+	"realestateinnepal.com":   "NPR",
+	"realestate.com.au/dubai": "AUD",
+}
+
+// ResolveSymbol maps a currency symbol or code (as extracted by a site
+// adapter) to its ISO-4217 code. source is the Property.Source, used to
+// disambiguate context-dependent symbols like "$". ok is false when the
+// symbol could not be resolved at all.
+func ResolveSymbol(symbol, source string) (code string, ok bool) {
+	key := strings.ToUpper(strings.TrimSpace(symbol))
+	if key == "" {
+		return fallbackForSource(source)
+	}
+
+	if code, found := unambiguousSymbols[key]; found {
+		return code, true
+	}
+
+	if key == "$" {
+		if code, found := sourceDefaults[source]; found {
+			return code, true
+		}
+		return "USD", true // most common meaning of a bare "$" with no other context
+	}
+
+	return fallbackForSource(source)
+}
+
+func fallbackForSource(source string) (string, bool) {
+	code, found := sourceDefaults[source]
+	return code, found
+}
@@ -0,0 +1,32 @@
+package fx
+
+import "testing"
+
+func TestResolveSymbol(t *testing.T) {
+	cases := []struct {
+		name     string
+		symbol   string
+		source   string
+		wantCode string
+		wantOK   bool
+	}{
+		{"NPR code", "NPR", "realestateinnepal.com", "NPR", true},
+		{"Rs shorthand", "Rs", "realestateinnepal.com", "NPR", true},
+		{"AED code", "AED", "realestate.com.au/dubai", "AED", true},
+		{"AUD code", "AUD", "realestate.com.au/dubai", "AUD", true},
+		{"USD code", "USD", "realestate.com.au/dubai", "USD", true},
+		{"ambiguous dollar on nepal site", "$", "realestateinnepal.com", "NPR", true},
+		{"ambiguous dollar with no source hint", "$", "unknown-source", "USD", true},
+		{"unresolvable symbol falls back to source default", "XYZ", "realestateinnepal.com", "NPR", true},
+		{"unresolvable symbol with no source default", "XYZ", "unknown-source", "", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			code, ok := ResolveSymbol(tc.symbol, tc.source)
+			if ok != tc.wantOK || code != tc.wantCode {
+				t.Errorf("ResolveSymbol(%q, %q) = (%q, %v), want (%q, %v)", tc.symbol, tc.source, code, ok, tc.wantCode, tc.wantOK)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
@@ -9,6 +10,24 @@ import (
 	"time"
 )
 
+// stageResult records the outcome of a single pipeline stage so a
+// failure leaves an inspectable record instead of just a terminal log
+// line before the process exits.
+type stageResult struct {
+	Name     string        `json:"name"`
+	Success  bool          `json:"success"`
+	Err      string        `json:"err,omitempty"`
+	Duration time.Duration `json:"durationNanos"`
+}
+
+// runReport is a full run's stage-by-stage record, written to
+// ../data/pipeline-reports so a failed run can be diagnosed after the
+// fact rather than only from scrollback.
+type runReport struct {
+	StartedAt time.Time     `json:"startedAt"`
+	Stages    []stageResult `json:"stages"`
+}
+
 func runCommand(name string, args ...string) error {
 	cmd := exec.Command(name, args...)
 	cmd.Stdout = os.Stdout
@@ -21,8 +40,48 @@ func runCommand(name string, args ...string) error {
 	return nil
 }
 
+// runStage runs fn, appends its outcome to report, and reports whether
+// the pipeline should continue to the next stage.
+func runStage(report *runReport, name string, fn func() error) bool {
+	log.Printf("--- Running: %s ---", name)
+	started := time.Now()
+	err := fn()
+	result := stageResult{Name: name, Success: err == nil, Duration: time.Since(started)}
+	if err != nil {
+		result.Err = err.Error()
+	}
+	report.Stages = append(report.Stages, result)
+
+	if err != nil {
+		log.Printf("%s failed: %v", name, err)
+		return false
+	}
+	log.Printf("%s completed.", name)
+	return true
+}
+
+// saveReport writes report as JSON to ../data/pipeline-reports and
+// returns the path written.
+func saveReport(report *runReport) (string, error) {
+	dir := filepath.Join("..", "data", "pipeline-reports")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("creating %s: %w", dir, err)
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("run-%d.json", report.StartedAt.Unix()))
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshaling pipeline report: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return "", fmt.Errorf("writing %s: %w", path, err)
+	}
+	return path, nil
+}
+
 func main() {
 	log.Println("Starting Real Estate Insights Data Pipeline...")
+	report := &runReport{StartedAt: time.Now()}
 
 	// Define paths relative to the pipeline.go executable
 	baseDir, err := os.Getwd()
@@ -31,58 +90,50 @@ func main() {
 	}
 	// Adjust baseDir to be the root of the project if running from pipeline/
 	// Assuming pipeline.go is in real-estate-insights/pipeline/
-	projectRoot := filepath.Join(baseDir, "..") 
+	projectRoot := filepath.Join(baseDir, "..")
 
 	extractPath := filepath.Join(projectRoot, "processing", "extract.py")
 	linkagePath := filepath.Join(projectRoot, "processing", "linkage.py")
 	ragPath := filepath.Join(projectRoot, "processing", "rag.py")
 	knowledgeGraphPath := filepath.Join(projectRoot, "processing", "knowledge_graph.py")
-
-	// 1. Run Scraper
-	log.Println("--- Step 1: Running Scraper ---")
-	// Run the scraper from its directory
 	scraperDir := filepath.Join(projectRoot, "scraper")
-	cmd := exec.Command("go", "run", "scraper.go")
-	cmd.Dir = scraperDir
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	if err := cmd.Run(); err != nil {
-		log.Fatalf("Scraper failed: %v", err)
-	}
-	log.Println("Scraper completed.")
-	time.Sleep(1 * time.Second) // Small delay for file system writes
 
-	// 2. Run Data Extraction
-	log.Println("--- Step 2: Running Data Extraction ---")
-	if err := runCommand("python", extractPath); err != nil {
-		log.Fatalf("Data Extraction failed: %v", err)
+	stages := []struct {
+		name string
+		fn   func() error
+	}{
+		{"Scraper", func() error {
+			cmd := exec.Command("go", "run", "scraper.go")
+			cmd.Dir = scraperDir
+			cmd.Stdout = os.Stdout
+			cmd.Stderr = os.Stderr
+			return cmd.Run()
+		}},
+		{"Data Extraction", func() error { return runCommand("python", extractPath) }},
+		{"Record Linkage", func() error { return runCommand("python", linkagePath) }},
+		{"Knowledge Graph Generation", func() error { return runCommand("python", knowledgeGraphPath) }},
+		{"RAG System", func() error { return runCommand("python", ragPath) }},
 	}
-	log.Println("Data Extraction completed.")
-	time.Sleep(1 * time.Second)
 
-	// 3. Run Record Linkage
-	log.Println("--- Step 3: Running Record Linkage ---")
-	if err := runCommand("python", linkagePath); err != nil {
-		log.Fatalf("Record Linkage failed: %v", err)
+	ok := true
+	for _, stage := range stages {
+		if !runStage(report, stage.name, stage.fn) {
+			ok = false
+			break
+		}
+		time.Sleep(1 * time.Second) // Small delay for file system writes
 	}
-	log.Println("Record Linkage completed.")
-	time.Sleep(1 * time.Second)
 
-	// 4. Run Knowledge Graph Generation
-	log.Println("--- Step 4: Running Knowledge Graph Generation ---")
-	if err := runCommand("python", knowledgeGraphPath); err != nil {
-		log.Fatalf("Knowledge Graph Generation failed: %v", err)
+	path, saveErr := saveReport(report)
+	if saveErr != nil {
+		log.Printf("Failed to save pipeline report: %v", saveErr)
+	} else {
+		log.Printf("Pipeline report written to %s", path)
 	}
-	log.Println("Knowledge Graph Generation completed.")
-	time.Sleep(1 * time.Second)
 
-	// 5. Run RAG System
-	log.Println("--- Step 5: Running RAG System ---")
-	if err := runCommand("python", ragPath); err != nil {
-		log.Fatalf("RAG System failed: %v", err)
+	if !ok {
+		log.Fatalf("Real Estate Insights Data Pipeline failed; see %s for stage details.", path)
 	}
-	log.Println("RAG System completed.")
-	time.Sleep(1 * time.Second)
 
 	log.Println("Real Estate Insights Data Pipeline finished successfully!")
-}
\ No newline at end of file
+}
@@ -0,0 +1,150 @@
+// Package pool manages the per-source scraper goroutines as
+// cancellable, pausable units, so a control surface (like the
+// dashboard) can suspend or stop one source without killing the whole
+// process.
+package pool
+
+import (
+	"context"
+	"sync"
+)
+
+// Status is a snapshot of one source's pool state.
+type Status struct {
+	Source string `json:"source"`
+	Paused bool   `json:"paused"`
+	Done   bool   `json:"done"`
+}
+
+// Pool tracks the pause/resume/cancel state for one source's worker
+// goroutine(s).
+type Pool struct {
+	source string
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu       sync.Mutex
+	paused   bool
+	resumeCh chan struct{} // closed and replaced whenever Resume is called
+	done     bool
+}
+
+func newPool(parent context.Context, source string) *Pool {
+	ctx, cancel := context.WithCancel(parent)
+	return &Pool{
+		source:   source,
+		ctx:      ctx,
+		cancel:   cancel,
+		resumeCh: make(chan struct{}),
+	}
+}
+
+// Context returns the pool's cancellable context; scraper goroutines
+// should select on ctx.Done() to stop promptly when the pool is
+// cancelled.
+func (p *Pool) Context() context.Context { return p.ctx }
+
+// Cancel stops the pool: its context is cancelled and any worker parked
+// in Wait returns.
+func (p *Pool) Cancel() { p.cancel() }
+
+// Pause suspends the pool. Workers calling Wait block until Resume is
+// called or the pool is cancelled.
+func (p *Pool) Pause() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.paused = true
+}
+
+// Resume unblocks any workers currently parked in Wait.
+func (p *Pool) Resume() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.paused {
+		return
+	}
+	p.paused = false
+	close(p.resumeCh)
+	p.resumeCh = make(chan struct{})
+}
+
+// Wait blocks while the pool is paused. It returns ctx.Err() if the
+// pool is cancelled while waiting, and nil otherwise.
+func (p *Pool) Wait() error {
+	for {
+		p.mu.Lock()
+		if !p.paused {
+			p.mu.Unlock()
+			return nil
+		}
+		resumeCh := p.resumeCh
+		p.mu.Unlock()
+
+		select {
+		case <-resumeCh:
+		case <-p.ctx.Done():
+			return p.ctx.Err()
+		}
+	}
+}
+
+// MarkDone records that the pool's work is finished, surfaced via
+// Status.
+func (p *Pool) MarkDone() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.done = true
+}
+
+func (p *Pool) status() Status {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return Status{Source: p.source, Paused: p.paused, Done: p.done}
+}
+
+// Manager owns one Pool per source and lets callers pause, resume, or
+// cancel any of them independently.
+type Manager struct {
+	parent context.Context
+
+	mu    sync.Mutex
+	pools map[string]*Pool
+}
+
+// NewManager returns a Manager whose pools are all children of parent;
+// cancelling parent cancels every pool.
+func NewManager(parent context.Context) *Manager {
+	return &Manager{parent: parent, pools: make(map[string]*Pool)}
+}
+
+// Register creates (or returns the existing) pool for source.
+func (m *Manager) Register(source string) *Pool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if p, ok := m.pools[source]; ok {
+		return p
+	}
+	p := newPool(m.parent, source)
+	m.pools[source] = p
+	return p
+}
+
+// Get returns the pool registered for source, if any.
+func (m *Manager) Get(source string) (*Pool, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	p, ok := m.pools[source]
+	return p, ok
+}
+
+// Snapshot returns the status of every registered pool.
+func (m *Manager) Snapshot() []Status {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	statuses := make([]Status, 0, len(m.pools))
+	for _, p := range m.pools {
+		statuses = append(statuses, p.status())
+	}
+	return statuses
+}
@@ -0,0 +1,20 @@
+package property
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+)
+
+// ContentHash returns a stable identifier for p derived from its URL (or,
+// failing that, its source/title/location), so re-scraping the same
+// listing across runs produces the same ID instead of a fresh
+// time.Now()-based one.
+func ContentHash(p Property) string {
+	key := p.URL
+	if key == "" {
+		key = fmt.Sprintf("%s|%s|%s", p.Source, p.Title, p.Location)
+	}
+	sum := sha1.Sum([]byte(key))
+	return hex.EncodeToString(sum[:])[:16]
+}
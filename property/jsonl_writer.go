@@ -0,0 +1,86 @@
+package property
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// JSONLWriter streams Property records to a JSONL file as they arrive,
+// deduping on ContentHash instead of keeping the full result set in
+// memory. It is safe for concurrent use by multiple scraper goroutines.
+type JSONLWriter struct {
+	mu      sync.Mutex
+	file    *os.File
+	writer  *bufio.Writer
+	encoder *json.Encoder
+	seen    map[string]bool
+}
+
+// OpenJSONLWriter appends to (or creates) path, preloading the set of
+// content hashes already written so a re-run of the scraper doesn't
+// duplicate listings collected by a prior run.
+func OpenJSONLWriter(path string) (*JSONLWriter, error) {
+	seen := make(map[string]bool)
+
+	if existing, err := os.Open(path); err == nil {
+		scanner := bufio.NewScanner(existing)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			var p Property
+			if err := json.Unmarshal(scanner.Bytes(), &p); err != nil {
+				continue // tolerate a truncated last line from a crashed run
+			}
+			seen[p.ID] = true
+		}
+		existing.Close()
+	} else if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("property: reading %s: %w", path, err)
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("property: opening %s: %w", path, err)
+	}
+
+	w := bufio.NewWriter(file)
+	return &JSONLWriter{file: file, writer: w, encoder: json.NewEncoder(w), seen: seen}, nil
+}
+
+// Write stamps p with its content hash if it doesn't already have an ID,
+// appends it to the file, and reports whether it was a new listing
+// (false means it was a duplicate of something already written).
+func (w *JSONLWriter) Write(p Property) (bool, error) {
+	if p.ID == "" {
+		p.ID = ContentHash(p)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.seen[p.ID] {
+		return false, nil
+	}
+
+	if err := w.encoder.Encode(p); err != nil {
+		return false, fmt.Errorf("property: writing record %s: %w", p.ID, err)
+	}
+	if err := w.writer.Flush(); err != nil {
+		return false, fmt.Errorf("property: flushing record %s: %w", p.ID, err)
+	}
+
+	w.seen[p.ID] = true
+	return true, nil
+}
+
+// Close flushes and closes the underlying file.
+func (w *JSONLWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err := w.writer.Flush(); err != nil {
+		return err
+	}
+	return w.file.Close()
+}
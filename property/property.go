@@ -0,0 +1,30 @@
+// Package property defines the shared Property domain type used by the
+// scraper, site adapters, and downstream processing stages.
+package property
+
+import "time"
+
+// Property represents a single real estate listing.
+type Property struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Price       float64   `json:"price"`
+	Currency    string    `json:"currency"`
+	Location    string    `json:"location"`
+	Description string    `json:"description"`
+	Bedrooms    int       `json:"bedrooms"`
+	Bathrooms   int       `json:"bathrooms"`
+	AreaSqFt    float64   `json:"areaSqFt"`
+	URL         string    `json:"url"`
+	ScrapedAt   time.Time `json:"scrapedAt"`
+	Source      string    `json:"source"` // Track which website the property came from
+
+	// PriceLocal and Currency are what the adapter extracted; PriceUSD,
+	// FXRate, and FXDate are filled in by the fx package so cross-market
+	// analytics (linkage.py, knowledge_graph.py) can compare listings in
+	// a common currency.
+	PriceLocal float64   `json:"priceLocal"`
+	PriceUSD   float64   `json:"priceUSD"`
+	FXRate     float64   `json:"fxRate"` // units of Currency per 1 USD, as of FXDate
+	FXDate     time.Time `json:"fxDate"`
+}
@@ -0,0 +1,22 @@
+package property
+
+// RequiredFields lists the fields a listing must have to be considered
+// usable. Used both to filter scraped properties and, in --dry-run
+// mode, to report which fields a new adapter's selectors are missing.
+var RequiredFields = []string{"title", "price", "location"}
+
+// MissingFields returns the subset of RequiredFields that p doesn't
+// populate.
+func MissingFields(p Property) []string {
+	var missing []string
+	if p.Title == "" {
+		missing = append(missing, "title")
+	}
+	if p.Price <= 0 {
+		missing = append(missing, "price")
+	}
+	if p.Location == "" {
+		missing = append(missing, "location")
+	}
+	return missing
+}
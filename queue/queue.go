@@ -0,0 +1,117 @@
+// Package queue implements a persistent crawl frontier: a file-based
+// append log of (URL, state, depth, last-seen hash) records so
+// interrupted runs can resume and already-scraped URLs are skipped on
+// the next run. The latest record for every URL is also held in memory,
+// so memory use grows with the number of distinct URLs visited.
+package queue
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// State is the visit state of a URL in the frontier.
+type State string
+
+const (
+	StatePending State = "pending"
+	StateDone    State = "done"
+	StateFailed  State = "failed"
+)
+
+// record is one line of the append log.
+type record struct {
+	URL    string    `json:"url"`
+	State  State     `json:"state"`
+	Depth  int       `json:"depth"`
+	Hash   string    `json:"hash"` // content hash of the last successful visit, for change detection
+	SeenAt time.Time `json:"seenAt"`
+}
+
+// Queue is a persistent, append-only visit log. It is safe for
+// concurrent use.
+type Queue struct {
+	mu      sync.Mutex
+	file    *os.File
+	encoder *json.Encoder
+	state   map[string]record
+}
+
+// Open loads path's existing records (if any) and opens it for
+// appending, so a new Queue can resume exactly where a prior run left
+// off.
+func Open(path string) (*Queue, error) {
+	state := make(map[string]record)
+
+	if existing, err := os.Open(path); err == nil {
+		scanner := bufio.NewScanner(existing)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			var r record
+			if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+				continue // tolerate a truncated last line from a crashed run
+			}
+			state[r.URL] = r
+		}
+		existing.Close()
+	} else if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("queue: reading %s: %w", path, err)
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("queue: opening %s: %w", path, err)
+	}
+
+	return &Queue{file: file, encoder: json.NewEncoder(file), state: state}, nil
+}
+
+// Close closes the underlying log file.
+func (q *Queue) Close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.file.Close()
+}
+
+// ShouldVisit reports whether url has not already been marked done in a
+// prior run and, if so, records it as pending at depth.
+func (q *Queue) ShouldVisit(url string, depth int) (bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if r, ok := q.state[url]; ok && r.State == StateDone {
+		return false, nil
+	}
+
+	return true, q.appendLocked(record{URL: url, State: StatePending, Depth: depth, SeenAt: time.Now()})
+}
+
+// MarkDone records url as successfully visited, along with the content
+// hash of what was extracted so future runs can tell whether the page
+// changed.
+func (q *Queue) MarkDone(url, hash string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.appendLocked(record{URL: url, State: StateDone, Hash: hash, SeenAt: time.Now()})
+}
+
+// MarkFailed records url as failed so it can be inspected. ShouldVisit
+// still returns true for a failed URL, so it is attempted again on the
+// next run, with no limit on the number of attempts.
+func (q *Queue) MarkFailed(url string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.appendLocked(record{URL: url, State: StateFailed, SeenAt: time.Now()})
+}
+
+func (q *Queue) appendLocked(r record) error {
+	q.state[r.URL] = r
+	if err := q.encoder.Encode(r); err != nil {
+		return fmt.Errorf("queue: appending record for %s: %w", r.URL, err)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package render
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chromedp/chromedp"
+)
+
+// ChromedpRenderer implements Renderer on top of a pool of reusable
+// headless Chromium contexts via chromedp.
+type ChromedpRenderer struct {
+	pool *browserPool
+}
+
+// NewChromedpRenderer launches poolSize headless browser contexts ready
+// to serve Render calls.
+func NewChromedpRenderer(ctx context.Context, poolSize int) (*ChromedpRenderer, error) {
+	if poolSize < 1 {
+		poolSize = 1
+	}
+	pool, err := newBrowserPool(ctx, poolSize)
+	if err != nil {
+		return nil, fmt.Errorf("render: launching browser pool: %w", err)
+	}
+	return &ChromedpRenderer{pool: pool}, nil
+}
+
+// Close shuts down every browser context in the pool.
+func (r *ChromedpRenderer) Close() { r.pool.Close() }
+
+// Render navigates to url in a pooled browser context, waits for
+// opts.WaitForSelector to become visible (or, with no selector, a fixed
+// 500ms), then returns the rendered HTML.
+func (r *ChromedpRenderer) Render(ctx context.Context, url string, opts Options) (string, error) {
+	idleTimeout := opts.NetworkIdleTimeout
+	if idleTimeout <= 0 {
+		idleTimeout = DefaultNetworkIdleTimeout
+	}
+
+	browserCtx, err := r.pool.checkout(ctx)
+	if err != nil {
+		return "", fmt.Errorf("render: checking out browser: %w", err)
+	}
+	defer r.pool.checkin(browserCtx)
+
+	taskCtx, cancel := context.WithTimeout(browserCtx, idleTimeout)
+	defer cancel()
+
+	actions := []chromedp.Action{chromedp.Navigate(url)}
+	if opts.WaitForSelector != "" {
+		actions = append(actions, chromedp.WaitVisible(opts.WaitForSelector, chromedp.ByQuery))
+	} else {
+		actions = append(actions, chromedp.Sleep(500*time.Millisecond)) // let lazy-loaded content settle
+	}
+
+	var html string
+	actions = append(actions, chromedp.OuterHTML("html", &html))
+
+	if err := chromedp.Run(taskCtx, actions...); err != nil {
+		return "", fmt.Errorf("render: rendering %s: %w", url, err)
+	}
+
+	return html, nil
+}
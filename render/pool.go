@@ -0,0 +1,63 @@
+package render
+
+import (
+	"context"
+
+	"github.com/chromedp/chromedp"
+)
+
+// browserPool holds a fixed number of pre-allocated chromedp browser
+// contexts so the cost of launching Chromium is amortized across many
+// Render calls instead of paid per page.
+type browserPool struct {
+	slots chan context.Context
+	stop  func()
+}
+
+// newBrowserPool launches size headless Chromium instances sharing one
+// allocator, ready to be checked out by Render calls.
+func newBrowserPool(parent context.Context, size int) (*browserPool, error) {
+	allocCtx, allocCancel := chromedp.NewExecAllocator(parent, chromedp.DefaultExecAllocatorOptions[:]...)
+
+	slots := make(chan context.Context, size)
+	var cancels []context.CancelFunc
+	for i := 0; i < size; i++ {
+		browserCtx, browserCancel := chromedp.NewContext(allocCtx)
+		if err := chromedp.Run(browserCtx); err != nil { // warm the browser up
+			browserCancel()
+			allocCancel()
+			return nil, err
+		}
+		cancels = append(cancels, browserCancel)
+		slots <- browserCtx
+	}
+
+	stop := func() {
+		for _, cancel := range cancels {
+			cancel()
+		}
+		allocCancel()
+	}
+
+	return &browserPool{slots: slots, stop: stop}, nil
+}
+
+// checkout blocks until a browser context is available or ctx is done.
+func (p *browserPool) checkout(ctx context.Context) (context.Context, error) {
+	select {
+	case browserCtx := <-p.slots:
+		return browserCtx, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// checkin returns a browser context to the pool for reuse.
+func (p *browserPool) checkin(browserCtx context.Context) {
+	p.slots <- browserCtx
+}
+
+// Close tears down every browser in the pool.
+func (p *browserPool) Close() {
+	p.stop()
+}
@@ -0,0 +1,32 @@
+// Package render provides a headless-browser rendering fallback for
+// listing pages that lazy-load content via JavaScript, where a plain
+// http.Get + goquery pass would see no results.
+package render
+
+import (
+	"context"
+	"time"
+)
+
+// Options configures a single Render call.
+type Options struct {
+	// WaitForSelector, if set, blocks until an element matching this CSS
+	// selector is visible before the HTML is captured.
+	WaitForSelector string
+
+	// NetworkIdleTimeout bounds the whole Render call (navigation, the
+	// selector wait, and HTML capture). If it elapses, Render returns an
+	// error and no HTML. Network activity itself is not watched. Zero
+	// uses a sane default.
+	NetworkIdleTimeout time.Duration
+}
+
+// DefaultNetworkIdleTimeout is used when Options.NetworkIdleTimeout is
+// left at zero.
+const DefaultNetworkIdleTimeout = 10 * time.Second
+
+// Renderer executes JavaScript on a page and returns the resulting
+// rendered HTML.
+type Renderer interface {
+	Render(ctx context.Context, url string, opts Options) (html string, err error)
+}
@@ -0,0 +1,89 @@
+// Package report aggregates per-URL scrape outcomes into a per-source
+// Report that's written to disk instead of being silently log.Printf'd,
+// so failures are inspectable after the fact.
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Result is the outcome of scraping a single URL.
+type Result struct {
+	URL            string    `json:"url"`
+	RedirectedURL  string    `json:"redirectedURL,omitempty"`
+	TimeStarted    time.Time `json:"timeStarted"`
+	TimeFinished   time.Time `json:"timeFinished"`
+	Success        bool      `json:"success"`
+	ExtractedCount int       `json:"extractedCount"`
+	Err            string    `json:"err,omitempty"`
+}
+
+// Report is a source's full set of results for one scrape run.
+type Report struct {
+	Source      string    `json:"source"`
+	GeneratedAt time.Time `json:"generatedAt"`
+	Results     []Result  `json:"results"`
+}
+
+// NewReport starts an empty report for source.
+func NewReport(source string, generatedAt time.Time) *Report {
+	return &Report{Source: source, GeneratedAt: generatedAt}
+}
+
+// Add records one URL's outcome.
+func (r *Report) Add(result Result) {
+	r.Results = append(r.Results, result)
+}
+
+// Succeeded and Failed summarize the report for a one-line log message.
+func (r *Report) Succeeded() int {
+	n := 0
+	for _, res := range r.Results {
+		if res.Success {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *Report) Failed() int { return len(r.Results) - r.Succeeded() }
+
+// Save writes the report as JSON to dir/<source>-<timestamp>.json,
+// creating dir if needed, and returns the path written.
+func (r *Report) Save(dir string) (string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("report: creating %s: %w", dir, err)
+	}
+
+	filename := fmt.Sprintf("%s-%d.json", sanitize(r.Source), r.GeneratedAt.Unix())
+	path := filepath.Join(dir, filename)
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("report: marshaling report for %s: %w", r.Source, err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return "", fmt.Errorf("report: writing %s: %w", path, err)
+	}
+
+	return path, nil
+}
+
+// sanitize replaces path-unfriendly characters in a source name (e.g.
+// "realestate.com.au/dubai") so it can be used as a filename.
+func sanitize(source string) string {
+	out := make([]rune, 0, len(source))
+	for _, r := range source {
+		switch r {
+		case '/', '\\', ' ':
+			out = append(out, '-')
+		default:
+			out = append(out, r)
+		}
+	}
+	return string(out)
+}
@@ -1,381 +1,309 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"flag"
 	"fmt"
 	"log"
-	"net/http"
-	"os"
-	"regexp"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
+
+	"github.com/Prashidha0O1/real_estates_insights/dashboard"
+	"github.com/Prashidha0O1/real_estates_insights/fetcher"
+	"github.com/Prashidha0O1/real_estates_insights/fx"
+	"github.com/Prashidha0O1/real_estates_insights/pool"
+	"github.com/Prashidha0O1/real_estates_insights/property"
+	"github.com/Prashidha0O1/real_estates_insights/queue"
+	"github.com/Prashidha0O1/real_estates_insights/render"
+	"github.com/Prashidha0O1/real_estates_insights/report"
+	"github.com/Prashidha0O1/real_estates_insights/sites"
 )
 
-// Property represents a single real estate listing.
-type Property struct {
-	ID          string    `json:"id"`
-	Title       string    `json:"title"`
-	Price       float64   `json:"price"`
-	Currency    string    `json:"currency"`
-	Location    string    `json:"location"`
-	Description string    `json:"description"`
-	Bedrooms    int       `json:"bedrooms"`
-	Bathrooms   int       `json:"bathrooms"`
-	AreaSqFt    float64   `json:"areaSqFt"`
-	URL         string    `json:"url"`
-	ScrapedAt   time.Time `json:"scrapedAt"`
-	Source      string    `json:"source"` // Track which website the property came from
-}
+// rulesDir holds the YAML site-adapter definitions, one file per portal.
+// Adding a new site is a matter of dropping a rules file here; nothing
+// in this file needs to change.
+const rulesDir = "sites"
 
-// ScraperConfig holds configuration for the scraper
-type ScraperConfig struct {
-	BaseURL       string
-	SearchPath    string
-	PagesToScrape int
-	Source        string
-}
+// htmlCacheDir holds raw responses keyed by URL hash so repeated runs
+// during development don't re-hit origins.
+const htmlCacheDir = "../data/.httpcache"
 
-// WebsiteConfig defines how to scrape different websites
-type WebsiteConfig struct {
-	Selector     string
-	TitleSelect  string
-	PriceSelect  string
-	LocationSelect string
-	URLSelect    string
-	BedroomRegex string
-	BathroomRegex string
-	AreaRegex    string
-	PriceRegex   string
-}
+// visitQueuePath and outputPath persist crawl progress and results
+// across runs, so an interrupted scrape can resume instead of starting
+// from scratch. Properties are streamed to disk rather than collected in
+// memory; the visit queue and the writer's dedup set still keep one
+// in-memory entry per URL and per listing ID.
+const visitQueuePath = "../data/visitqueue.jsonl"
+const outputPath = "../data/properties.jsonl"
+
+// fxRatesPath is the local historical exchange-rate cache used to
+// convert each listing's price to USD.
+const fxRatesPath = "../data/fxrates.csv"
+
+// reportsDir holds one JSON report per source per run, recording what
+// succeeded, what failed, and why.
+const reportsDir = "../data/reports"
+
+// dashboardAddr is where the control UI/API listens. Empty disables it.
+const dashboardAddr = ":8090"
+
+// renderPoolSize bounds how many headless browser contexts are kept
+// warm for adapters that declare RequiresJS.
+const renderPoolSize = 2
 
 func main() {
-	// Define multiple websites to scrape
-	websites := []ScraperConfig{
-		{
-			BaseURL:       "https://www.realestateinnepal.com/",
-			SearchPath:    "/search-result/?location=kathmandu",
-			PagesToScrape: 2,
-			Source:        "realestateinnepal.com",
-		},
-		{
-			BaseURL:       "https://www.realestate.com.au/international/ae/dubai",
-			SearchPath:    "",
-			PagesToScrape: 3,
-			Source:        "realestate.com.au/dubai",
-		},
-	}
+	dryRun := flag.Bool("dry-run", false, "fetch one page per source and report what would be extracted, without writing output or running downstream stages")
+	flag.Parse()
 
-	allProperties := make(chan Property)
-	var wg sync.WaitGroup
-	var mu sync.Mutex
-	var propertiesCollected []Property
-
-	// Collect properties from channel
-	go func() {
-		for prop := range allProperties {
-			mu.Lock()
-			propertiesCollected = append(propertiesCollected, prop)
-			mu.Unlock()
-		}
-	}()
+	adapters, err := sites.LoadRules(rulesDir)
+	if err != nil {
+		log.Fatalf("Failed to load site adapters: %v", err)
+	}
+	if len(adapters) == 0 {
+		log.Fatalf("No site adapters found in %s", rulesDir)
+	}
 
-	// Scrape each website
-	for _, config := range websites {
-		wg.Add(1)
-		go func(cfg ScraperConfig) {
-			defer wg.Done()
-			log.Printf("Starting to scrape: %s", cfg.Source)
-			
-			if cfg.Source == "realestate.com.au/dubai" {
-				scrapeDubaiProperties(cfg, allProperties)
-			} else {
-				scrapeNepalProperties(cfg, allProperties)
+	var renderer *render.ChromedpRenderer
+	for _, a := range adapters {
+		if a.RequiresJS() {
+			renderer, err = render.NewChromedpRenderer(context.Background(), renderPoolSize)
+			if err != nil {
+				log.Fatalf("Failed to set up headless renderer: %v", err)
 			}
-		}(config)
+			defer renderer.Close()
+			break
+		}
 	}
 
-	wg.Wait()
-	close(allProperties)
+	fetcherCfg := fetcher.Config{
+		MinHostDelay: 2 * time.Second,
+		CacheDir:     htmlCacheDir,
+	}
+	if renderer != nil {
+		fetcherCfg.Renderer = renderer
+	}
+	fetch, err := fetcher.New(fetcherCfg)
+	if err != nil {
+		log.Fatalf("Failed to set up fetcher: %v", err)
+	}
 
-	time.Sleep(1 * time.Second)
+	if *dryRun {
+		runDryRun(adapters, fetch)
+		return
+	}
 
-	// Save to file
-	outputPath := "../data/properties.json"
-	file, err := json.MarshalIndent(propertiesCollected, "", "  ")
+	visited, err := queue.Open(visitQueuePath)
 	if err != nil {
-		log.Fatalf("Failed to marshal properties: %v", err)
+		log.Fatalf("Failed to open visit queue: %v", err)
 	}
+	defer visited.Close()
 
-	err = saveToFile(outputPath, file)
+	writer, err := property.OpenJSONLWriter(outputPath)
 	if err != nil {
-		log.Fatalf("Failed to save properties to file: %v", err)
+		log.Fatalf("Failed to open output writer: %v", err)
 	}
-	log.Printf("Scraping complete. Saved %d properties to %s", len(propertiesCollected), outputPath)
-}
+	defer writer.Close()
 
-func scrapeDubaiProperties(config ScraperConfig, allProperties chan<- Property) {
-	url := config.BaseURL
-	log.Printf("Scraping Dubai properties from: %s", url)
-	
-	res, err := http.Get(url)
+	fxProvider, err := fx.LoadCSVProvider(fxRatesPath)
 	if err != nil {
-		log.Printf("Failed to fetch Dubai URL %s: %v", url, err)
-		return
+		log.Fatalf("Failed to load FX rates: %v", err)
 	}
-	defer res.Body.Close()
+	converter := fx.NewConverter(fxProvider)
 
-	if res.StatusCode != 200 {
-		log.Printf("Received non-200 status code for Dubai: %d %s", res.StatusCode, res.Status)
-		return
+	siteConfigs := make([]dashboard.SiteConfig, 0, len(adapters))
+	for _, a := range adapters {
+		siteConfigs = append(siteConfigs, dashboard.SiteConfig{Source: a.Name(), PagesToScrape: 0})
 	}
+	dashCfg := dashboard.NewConfig(siteConfigs)
 
-	doc, err := goquery.NewDocumentFromReader(res.Body)
-	if err != nil {
-		log.Printf("Failed to parse Dubai HTML: %v", err)
-		return
+	poolMgr := pool.NewManager(context.Background())
+	board := dashboard.New(poolMgr, dashCfg)
+
+	if dashboardAddr != "" {
+		go func() {
+			log.Printf("Dashboard listening on %s", dashboardAddr)
+			if err := board.ListenAndServe(dashboardAddr); err != nil {
+				log.Printf("Dashboard stopped: %v", err)
+			}
+		}()
 	}
 
-	// Based on the realestate.com.au Dubai page structure
-	doc.Find("a[href*='/international/ae/']").Each(func(i int, s *goquery.Selection) {
-		// Extract property URL
-		propertyURL, exists := s.Attr("href")
-		if !exists || !strings.Contains(propertyURL, "/international/ae/") {
-			return
-		}
-		
-		// Make URL absolute
-		if !strings.HasPrefix(propertyURL, "http") {
-			propertyURL = "https://www.realestate.com.au" + propertyURL
-		}
+	var wg sync.WaitGroup
+	var written int
+	var writtenMu sync.Mutex
 
-		// Extract title from the link text or nearby elements
-		title := strings.TrimSpace(s.Text())
-		if title == "" {
-			title = s.Find("h3, h4, .property-title").First().Text()
-		}
+	// Scrape each site through its own pausable, cancellable pool
+	for _, adapter := range adapters {
+		p := poolMgr.Register(adapter.Name())
+		wg.Add(1)
+		go func(a sites.SiteAdapter, p *pool.Pool) {
+			defer wg.Done()
+			defer p.MarkDone()
+			log.Printf("Starting to scrape: %s", a.Name())
+			n, rep := scrapeSite(a, p, fetch, visited, writer, converter, dashCfg, board)
 
-		// Extract price - look for price elements
-		priceText := s.Find("[class*='price'], .price, strong").First().Text()
-		price, currency := parseDubaiPrice(priceText)
-
-		// Extract location
-		location := extractDubaiLocation(s)
-
-		// Extract bedrooms and area from the text
-		fullText := s.Text()
-		bedrooms := extractBedrooms(fullText)
-		area := extractDubaiArea(fullText)
-
-		// Generate unique ID
-		id := fmt.Sprintf("dubai-%d-%d", time.Now().UnixNano(), i)
-
-		prop := Property{
-			ID:          id,
-			Title:       title,
-			Price:       price,
-			Currency:    currency,
-			Location:    location,
-			Description: fmt.Sprintf("%s - %s", title, location),
-			Bedrooms:    bedrooms,
-			Bathrooms:   0, // Not easily extractable from this view
-			AreaSqFt:    area,
-			URL:         propertyURL,
-			ScrapedAt:   time.Now(),
-			Source:      config.Source,
-		}
+			if path, err := rep.Save(reportsDir); err != nil {
+				log.Printf("[%s] Failed to save report: %v", a.Name(), err)
+			} else {
+				log.Printf("[%s] Report: %d succeeded, %d failed (%s)", a.Name(), rep.Succeeded(), rep.Failed(), path)
+			}
 
-		// Only add if we have meaningful data
-		if title != "" && price > 0 {
-			allProperties <- prop
-		}
-	})
-}
+			writtenMu.Lock()
+			written += n
+			writtenMu.Unlock()
+		}(adapter, p)
+	}
 
-func scrapeNepalProperties(config ScraperConfig, allProperties chan<- Property) {
-	for i := 1; i <= config.PagesToScrape; i++ {
-		url := fmt.Sprintf("%s%s%d", config.BaseURL, config.SearchPath, i)
-		log.Printf("Scraping Nepal page: %s", url)
-		
-		res, err := http.Get(url)
-		if err != nil {
-			log.Printf("Failed to fetch Nepal URL %s: %v", url, err)
-			continue
-		}
-		defer res.Body.Close()
+	wg.Wait()
 
-		if res.StatusCode != 200 {
-			log.Printf("Received non-200 status code for Nepal: %d %s", res.StatusCode, res.Status)
-			continue
+	log.Printf("Scraping complete. Wrote %d new properties to %s", written, outputPath)
+
+	for source, m := range fetch.Metrics().Snapshot() {
+		log.Printf("[%s] requests=%d cacheHits=%d retries=%d failures=%d", source, m.Requests, m.CacheHits, m.Retries, m.Failures)
+	}
+}
+
+// scrapeSite walks an adapter's paginated listing URLs, skipping any
+// already marked done in visited, fetches and parses each remaining
+// page, and streams normalized properties to writer. It pauses whenever
+// p is paused (via the dashboard) and stops promptly if p is cancelled.
+// It returns the number of new (non-duplicate) properties written and a
+// report of every URL's outcome.
+func scrapeSite(adapter sites.SiteAdapter, p *pool.Pool, fetch *fetcher.Fetcher, visited *queue.Queue, writer *property.JSONLWriter, converter *fx.Converter, cfg *dashboard.Config, board *dashboard.Dashboard) (int, *report.Report) {
+	written := 0
+	rep := report.NewReport(adapter.Name(), time.Now())
+
+	for page := 1; ; page++ {
+		if maxPages, ok := cfg.PagesToScrape(adapter.Name()); ok && maxPages > 0 && page > maxPages {
+			return written, rep
 		}
 
-		doc, err := goquery.NewDocumentFromReader(res.Body)
-		if err != nil {
-			log.Printf("Failed to parse Nepal HTML: %v", err)
-			continue
+		urls := adapter.ListURLs(page)
+		if len(urls) == 0 {
+			return written, rep
 		}
 
-		// Based on the actual website structure from realestateinnepal.com
-		doc.Find("article, .property-item, .listing-item").Each(func(i int, s *goquery.Selection) {
-			// Extract property ID from code or generate one
-			codeElement := s.Find("code, .property-code").First()
-			id := strings.TrimSpace(codeElement.Text())
-			if id == "" {
-				id = fmt.Sprintf("nepal-%d-%d", time.Now().UnixNano(), i)
+		for _, url := range urls {
+			if err := p.Wait(); err != nil {
+				log.Printf("[%s] Stopping: %v", adapter.Name(), err)
+				return written, rep
 			}
 
-			// Extract title
-			titleElement := s.Find("h3, h4, .property-title").First()
-			title := strings.TrimSpace(titleElement.Text())
-
-			// Extract price
-			priceElement := s.Find(".price, [class*='price'], strong").First()
-			priceStr := strings.TrimSpace(priceElement.Text())
-
-			// Extract location
-			locationElement := s.Find(".location, [class*='location'], p").First()
-			location := strings.TrimSpace(locationElement.Text())
+			shouldVisit, err := visited.ShouldVisit(url, page)
+			if err != nil {
+				log.Printf("[%s] Visit queue error for %s: %v", adapter.Name(), url, err)
+				continue
+			}
+			if !shouldVisit {
+				log.Printf("[%s] Skipping already-scraped: %s", adapter.Name(), url)
+				continue
+			}
 
-			// Extract description (might be in title or location if no separate description)
-			description := title
-			if location != "" && location != title {
-				description = fmt.Sprintf("%s - %s", title, location)
+			log.Printf("[%s] Scraping: %s", adapter.Name(), url)
+			result := report.Result{URL: url, TimeStarted: time.Now()}
+
+			res, err := fetch.FetchPage(p.Context(), url, adapter.Name(), adapter.RequiresJS(), adapter.RenderOptions())
+			result.TimeFinished = time.Now()
+			result.RedirectedURL = res.FinalURL
+			if err != nil {
+				log.Printf("[%s] Failed to fetch %s: %v", adapter.Name(), url, err)
+				visited.MarkFailed(url)
+				result.Err = err.Error()
+				rep.Add(result)
+				continue
 			}
 
-			// Extract URL
-			linkElement := s.Find("a").First()
-			propertyURL, _ := linkElement.Attr("href")
-			if propertyURL != "" && !strings.HasPrefix(propertyURL, "http") {
-				propertyURL = "https://www.realestateinnepal.com" + propertyURL
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(res.Body))
+			if err != nil {
+				log.Printf("[%s] Failed to parse HTML for %s: %v", adapter.Name(), url, err)
+				visited.MarkFailed(url)
+				result.Err = fmt.Errorf("parsing HTML: %w", err).Error()
+				rep.Add(result)
+				continue
 			}
 
-			// Parse price and currency
-			price, currency := parsePrice(priceStr)
-
-			// Extract bedrooms and bathrooms from the property details
-			detailsText := s.Text()
-			bedrooms := extractBedrooms(detailsText)
-			bathrooms := extractBathrooms(detailsText)
-			area := extractArea(detailsText)
-
-			prop := Property{
-				ID:          id,
-				Title:       title,
-				Price:       price,
-				Currency:    currency,
-				Location:    location,
-				Description: description,
-				Bedrooms:    bedrooms,
-				Bathrooms:   bathrooms,
-				AreaSqFt:    area,
-				URL:         propertyURL,
-				ScrapedAt:   time.Now(),
-				Source:      config.Source,
+			pageHash := hashBody(res.Body)
+			extracted := 0
+			for _, prop := range adapter.ParseListing(doc) {
+				prop = adapter.Normalize(prop)
+				if len(property.MissingFields(prop)) > 0 {
+					continue
+				}
+				prop = converter.Augment(prop)
+				isNew, err := writer.Write(prop)
+				if err != nil {
+					log.Printf("[%s] Failed to write property: %v", adapter.Name(), err)
+					continue
+				}
+				extracted++
+				if isNew {
+					written++
+					board.Publish(prop)
+				}
 			}
-			allProperties <- prop
-		})
 
-		time.Sleep(2 * time.Second) // Be respectful to the server
-	}
-}
+			result.Success = true
+			result.ExtractedCount = extracted
+			rep.Add(result)
 
-// Helper functions for Dubai properties
-func parseDubaiPrice(priceStr string) (float64, string) {
-	// Handle formats like "AUD $393,161" or "AED 934,000"
-	priceStr = strings.ReplaceAll(priceStr, ",", "")
-	re := regexp.MustCompile(`([A-Z]{3})\s*\$?\s*([\d.]+)`)
-	matches := re.FindStringSubmatch(priceStr)
-	if len(matches) > 2 {
-		currency := strings.TrimSpace(matches[1])
-		price, err := strconv.ParseFloat(matches[2], 64)
-		if err == nil {
-			return price, currency
+			visited.MarkDone(url, pageHash)
 		}
 	}
-	return 0.0, ""
 }
 
-func extractDubaiLocation(s *goquery.Selection) string {
-	// Look for location in various elements
-	location := s.Find(".location, [class*='location'], .address").First().Text()
-	if location == "" {
-		// Try to extract from the full text
-		fullText := s.Text()
-		// Look for patterns like "Dubai, Dubai, Dubai" or specific areas
-		re := regexp.MustCompile(`([A-Za-z\s]+),\s*Dubai`)
-		matches := re.FindStringSubmatch(fullText)
-		if len(matches) > 1 {
-			location = strings.TrimSpace(matches[1])
+// runDryRun fetches a single page per adapter, runs its selectors, and
+// reports how many properties would have been extracted and which
+// required fields were missing, without touching the visit queue,
+// output file, or any downstream stage.
+func runDryRun(adapters []sites.SiteAdapter, fetch *fetcher.Fetcher) {
+	for _, adapter := range adapters {
+		urls := adapter.ListURLs(1)
+		if len(urls) == 0 {
+			log.Printf("[dry-run] %s: no URLs for page 1", adapter.Name())
+			continue
 		}
-	}
-	return strings.TrimSpace(location)
-}
+		url := urls[0]
 
-func extractDubaiArea(text string) float64 {
-	// Look for area in m2 format
-	re := regexp.MustCompile(`(\d+(?:\.\d+)?)\s*m2`)
-	matches := re.FindStringSubmatch(text)
-	if len(matches) > 1 {
-		if area, err := strconv.ParseFloat(matches[1], 64); err == nil {
-			// Convert m2 to sqft (1 m2 = 10.764 sqft)
-			return area * 10.764
+		res, err := fetch.FetchPage(context.Background(), url, adapter.Name(), adapter.RequiresJS(), adapter.RenderOptions())
+		if err != nil {
+			log.Printf("[dry-run] %s: failed to fetch %s: %v", adapter.Name(), url, err)
+			continue
 		}
-	}
-	return 0.0
-}
 
-// Helper functions to extract property details from text
-func extractBedrooms(text string) int {
-	re := regexp.MustCompile(`(\d+)\s*Bed`)
-	matches := re.FindStringSubmatch(text)
-	if len(matches) > 1 {
-		if beds, err := strconv.Atoi(matches[1]); err == nil {
-			return beds
+		doc, err := goquery.NewDocumentFromReader(strings.NewReader(res.Body))
+		if err != nil {
+			log.Printf("[dry-run] %s: failed to parse %s: %v", adapter.Name(), url, err)
+			continue
 		}
-	}
-	return 0
-}
 
-func extractBathrooms(text string) int {
-	re := regexp.MustCompile(`(\d+)\s*Bath`)
-	matches := re.FindStringSubmatch(text)
-	if len(matches) > 1 {
-		if baths, err := strconv.Atoi(matches[1]); err == nil {
-			return baths
+		listings := adapter.ParseListing(doc)
+		missingCounts := make(map[string]int)
+		wouldExtract := 0
+		for _, prop := range listings {
+			prop = adapter.Normalize(prop)
+			missing := property.MissingFields(prop)
+			if len(missing) == 0 {
+				wouldExtract++
+				continue
+			}
+			for _, field := range missing {
+				missingCounts[field]++
+			}
 		}
-	}
-	return 0
-}
 
-func extractArea(text string) float64 {
-	re := regexp.MustCompile(`(\d+(?:\.\d+)?)\s*sqft`)
-	matches := re.FindStringSubmatch(text)
-	if len(matches) > 1 {
-		if area, err := strconv.ParseFloat(matches[1], 64); err == nil {
-			return area
-		}
+		log.Printf("[dry-run] %s: found %d candidate listings, %d would be extracted, missing-field counts: %v",
+			adapter.Name(), len(listings), wouldExtract, missingCounts)
 	}
-	return 0.0
 }
 
-// parsePrice attempts to extract price and currency from a string.
-func parsePrice(priceStr string) (float64, string) {
-	priceStr = strings.ReplaceAll(priceStr, ",", "") // Remove commas
-	re := regexp.MustCompile(`([A-Z$€£]+)?\s*([\d.]+)`)
-	matches := re.FindStringSubmatch(priceStr)
-	if len(matches) > 2 {
-		currency := strings.TrimSpace(matches[1])
-		price, err := strconv.ParseFloat(matches[2], 64)
-		if err == nil {
-			return price, currency
-		}
-	}
-	return 0.0, ""
+// hashBody returns a short content hash used to detect whether a page
+// changed since it was last scraped.
+func hashBody(body string) string {
+	sum := sha1.Sum([]byte(body))
+	return hex.EncodeToString(sum[:])[:16]
 }
-
-func saveToFile(filepath string, data []byte) error {
-	return os.WriteFile(filepath, data, 0644)
-}
\ No newline at end of file
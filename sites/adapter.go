@@ -0,0 +1,42 @@
+// Package sites turns external YAML rule files into SiteAdapter
+// implementations, so new real-estate portals can be added without
+// recompiling the scraper.
+package sites
+
+import (
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/Prashidha0O1/real_estates_insights/property"
+	"github.com/Prashidha0O1/real_estates_insights/render"
+)
+
+// SiteAdapter knows how to enumerate listing pages for one site, parse a
+// fetched page into raw Property records, and normalize those records
+// into the shared schema. Implementations should be safe to reuse across
+// goroutines; they hold no per-request state.
+type SiteAdapter interface {
+	// Name identifies the adapter, used as Property.Source and in logs.
+	Name() string
+
+	// RequiresJS reports whether listing pages need JavaScript rendering
+	// before goquery can see any results.
+	RequiresJS() bool
+
+	// RenderOptions returns the wait-for-selector and network-idle
+	// settings to use when RequiresJS is true.
+	RenderOptions() render.Options
+
+	// ListURLs returns the listing page URLs to fetch for the given
+	// 1-indexed page number. An empty slice means there is no such page.
+	ListURLs(page int) []string
+
+	// ParseListing extracts raw Property records from a fetched listing
+	// page. Fields are taken verbatim from the page; Normalize is
+	// responsible for cleanup.
+	ParseListing(doc *goquery.Document) []property.Property
+
+	// Normalize cleans up a raw Property extracted by ParseListing
+	// (currency symbols, unit conversions, etc). It leaves p.ID unset;
+	// property.JSONLWriter stamps the ID from a content hash.
+	Normalize(p property.Property) property.Property
+}
@@ -0,0 +1,49 @@
+package sites
+
+// WebsiteConfig describes how to scrape one site: the CSS selectors used
+// to find each listing and its fields, plus the regexes used to pull
+// numeric details (bedrooms, bathrooms, area, price) out of free text.
+type WebsiteConfig struct {
+	Selector       string `yaml:"selector"`
+	TitleSelect    string `yaml:"titleSelect"`
+	PriceSelect    string `yaml:"priceSelect"`
+	LocationSelect string `yaml:"locationSelect"`
+	URLSelect      string `yaml:"urlSelect"`
+	BedroomRegex   string `yaml:"bedroomRegex"`
+	BathroomRegex  string `yaml:"bathroomRegex"`
+	AreaRegex      string `yaml:"areaRegex"`
+	PriceRegex     string `yaml:"priceRegex"`
+}
+
+// Pagination describes how to build successive listing page URLs.
+type Pagination struct {
+	// Template is formatted with fmt.Sprintf(Template, BaseURL, SearchPath,
+	// page) to build a page URL, e.g. "%s%s&page=%d".
+	Template string `yaml:"template"`
+	// Pages caps how many pages ListURLs will generate. Required (must be
+	// > 0) whenever Template is set — NewRuleAdapter rejects a non-empty
+	// Template with Pages <= 0, since an unbounded page count would crawl
+	// the site forever.
+	Pages int `yaml:"pages"`
+}
+
+// RuleSet is the top-level shape of a site's YAML rules file.
+type RuleSet struct {
+	Name       string            `yaml:"name"`
+	BaseURL    string            `yaml:"baseURL"`
+	SearchPath string            `yaml:"searchPath"`
+	RequiresJS bool              `yaml:"requiresJS"`
+	Pagination Pagination        `yaml:"pagination"`
+	Website    WebsiteConfig     `yaml:"website"`
+	Currencies map[string]string `yaml:"currencies"` // symbol/code -> ISO-4217 code
+
+	// Render configures the headless-browser fallback used when
+	// RequiresJS is true; ignored otherwise.
+	Render RenderConfig `yaml:"render"`
+}
+
+// RenderConfig declares how a RequiresJS site should be rendered.
+type RenderConfig struct {
+	WaitForSelector           string `yaml:"waitForSelector"`
+	NetworkIdleTimeoutSeconds int    `yaml:"networkIdleTimeoutSeconds"`
+}
@@ -0,0 +1,53 @@
+package sites
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LoadRules reads every *.yaml/*.yml file in dir and returns a
+// SiteAdapter for each one. Files that fail to parse are reported as an
+// error rather than skipped, so a typo in a new adapter's rules file is
+// caught immediately instead of silently dropping a site.
+func LoadRules(dir string) ([]SiteAdapter, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("sites: reading rules dir %s: %w", dir, err)
+	}
+
+	var adapters []SiteAdapter
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		ext := filepath.Ext(entry.Name())
+		if ext != ".yaml" && ext != ".yml" {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("sites: reading %s: %w", path, err)
+		}
+
+		var rules RuleSet
+		if err := yaml.Unmarshal(raw, &rules); err != nil {
+			return nil, fmt.Errorf("sites: parsing %s: %w", path, err)
+		}
+		if rules.Name == "" {
+			rules.Name = entry.Name()
+		}
+
+		adapter, err := NewRuleAdapter(rules)
+		if err != nil {
+			return nil, err
+		}
+		adapters = append(adapters, adapter)
+	}
+
+	return adapters, nil
+}
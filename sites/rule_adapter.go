@@ -0,0 +1,180 @@
+package sites
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/Prashidha0O1/real_estates_insights/property"
+	"github.com/Prashidha0O1/real_estates_insights/render"
+)
+
+// RuleAdapter is a SiteAdapter driven entirely by a RuleSet loaded from
+// YAML. It replaces the old hardcoded scrapeDubaiProperties /
+// scrapeNepalProperties functions with a single generic implementation.
+type RuleAdapter struct {
+	rules RuleSet
+
+	bedroomRe  *regexp.Regexp
+	bathroomRe *regexp.Regexp
+	areaRe     *regexp.Regexp
+	priceRe    *regexp.Regexp
+}
+
+// NewRuleAdapter compiles the regexes declared in rules and returns a
+// ready-to-use adapter. It returns an error if any regex fails to
+// compile, so bad YAML is caught at load time rather than mid-crawl.
+func NewRuleAdapter(rules RuleSet) (*RuleAdapter, error) {
+	a := &RuleAdapter{rules: rules}
+
+	compile := func(pattern string) (*regexp.Regexp, error) {
+		if pattern == "" {
+			return nil, nil
+		}
+		return regexp.Compile(pattern)
+	}
+
+	var err error
+	if a.bedroomRe, err = compile(rules.Website.BedroomRegex); err != nil {
+		return nil, fmt.Errorf("sites: %s: bad bedroomRegex: %w", rules.Name, err)
+	}
+	if a.bathroomRe, err = compile(rules.Website.BathroomRegex); err != nil {
+		return nil, fmt.Errorf("sites: %s: bad bathroomRegex: %w", rules.Name, err)
+	}
+	if a.areaRe, err = compile(rules.Website.AreaRegex); err != nil {
+		return nil, fmt.Errorf("sites: %s: bad areaRegex: %w", rules.Name, err)
+	}
+	if a.priceRe, err = compile(rules.Website.PriceRegex); err != nil {
+		return nil, fmt.Errorf("sites: %s: bad priceRegex: %w", rules.Name, err)
+	}
+
+	if rules.Pagination.Template != "" && rules.Pagination.Pages <= 0 {
+		return nil, fmt.Errorf("sites: %s: pagination.template is set but pagination.pages is %d; an unbounded page count would crawl the site forever", rules.Name, rules.Pagination.Pages)
+	}
+
+	return a, nil
+}
+
+func (a *RuleAdapter) Name() string     { return a.rules.Name }
+func (a *RuleAdapter) RequiresJS() bool { return a.rules.RequiresJS }
+
+func (a *RuleAdapter) RenderOptions() render.Options {
+	return render.Options{
+		WaitForSelector:    a.rules.Render.WaitForSelector,
+		NetworkIdleTimeout: time.Duration(a.rules.Render.NetworkIdleTimeoutSeconds) * time.Second,
+	}
+}
+
+func (a *RuleAdapter) ListURLs(page int) []string {
+	if page < 1 || (a.rules.Pagination.Pages > 0 && page > a.rules.Pagination.Pages) {
+		return nil
+	}
+	baseURL := strings.TrimRight(a.rules.BaseURL, "/")
+	tmpl := a.rules.Pagination.Template
+	if tmpl == "" {
+		if page > 1 {
+			return nil
+		}
+		return []string{baseURL + a.rules.SearchPath}
+	}
+	return []string{fmt.Sprintf(tmpl, baseURL, a.rules.SearchPath, page)}
+}
+
+func (a *RuleAdapter) ParseListing(doc *goquery.Document) []property.Property {
+	var out []property.Property
+
+	doc.Find(a.rules.Website.Selector).Each(func(i int, s *goquery.Selection) {
+		title := a.selectText(s, a.rules.Website.TitleSelect, s.Text())
+		priceText := a.selectText(s, a.rules.Website.PriceSelect, "")
+		location := a.selectText(s, a.rules.Website.LocationSelect, "")
+
+		propertyURL, _ := s.Find(a.rules.Website.URLSelect).First().Attr("href")
+		if propertyURL == "" {
+			propertyURL, _ = s.Attr("href")
+		}
+		if propertyURL != "" && !strings.HasPrefix(propertyURL, "http") {
+			propertyURL = strings.TrimRight(a.rules.BaseURL, "/") + propertyURL
+		}
+
+		fullText := s.Text()
+		price, currency := a.extractPrice(priceText)
+		bedrooms := int(a.extractFloat(a.bedroomRe, fullText))
+		bathrooms := int(a.extractFloat(a.bathroomRe, fullText))
+		area := a.extractFloat(a.areaRe, fullText)
+
+		out = append(out, property.Property{
+			Title:       title,
+			Price:       price,
+			Currency:    currency,
+			Location:    location,
+			Description: strings.TrimSpace(fmt.Sprintf("%s - %s", title, location)),
+			Bedrooms:    bedrooms,
+			Bathrooms:   bathrooms,
+			AreaSqFt:    area,
+			URL:         propertyURL,
+			ScrapedAt:   time.Now(),
+			Source:      a.rules.Name,
+		})
+	})
+
+	return out
+}
+
+// Normalize resolves the source currency symbol/code to its ISO-4217
+// equivalent using the rule set's currency map. It leaves p.ID unset so
+// property.JSONLWriter stamps it with a content hash, keeping re-scrapes
+// of the same listing deduped instead of minted as fresh records.
+func (a *RuleAdapter) Normalize(p property.Property) property.Property {
+	if code, ok := a.rules.Currencies[p.Currency]; ok {
+		p.Currency = code
+	}
+	return p
+}
+
+func (a *RuleAdapter) selectText(s *goquery.Selection, selector, fallback string) string {
+	if selector == "" {
+		return strings.TrimSpace(fallback)
+	}
+	text := strings.TrimSpace(s.Find(selector).First().Text())
+	if text == "" {
+		return strings.TrimSpace(fallback)
+	}
+	return text
+}
+
+// extractPrice applies PriceRegex, expecting an optional currency symbol
+// or code in group 1 and the numeric amount in group 2 (e.g. "AED
+// 934,000" or "$393,161").
+func (a *RuleAdapter) extractPrice(text string) (float64, string) {
+	if a.priceRe == nil {
+		return 0, ""
+	}
+	matches := a.priceRe.FindStringSubmatch(strings.ReplaceAll(text, ",", ""))
+	if len(matches) < 3 {
+		return 0, ""
+	}
+	price, err := strconv.ParseFloat(matches[2], 64)
+	if err != nil {
+		return 0, ""
+	}
+	return price, strings.TrimSpace(matches[1])
+}
+
+func (a *RuleAdapter) extractFloat(re *regexp.Regexp, text string) float64 {
+	if re == nil {
+		return 0
+	}
+	matches := re.FindStringSubmatch(strings.ReplaceAll(text, ",", ""))
+	if len(matches) < 2 {
+		return 0
+	}
+	value, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return 0
+	}
+	return value
+}
@@ -0,0 +1,131 @@
+package sites
+
+import (
+	"os"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const scraperRulesDir = "../scraper/sites"
+
+func loadFixture(t *testing.T, path string) *goquery.Document {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("opening fixture %s: %v", path, err)
+	}
+	defer f.Close()
+
+	doc, err := goquery.NewDocumentFromReader(f)
+	if err != nil {
+		t.Fatalf("parsing fixture %s: %v", path, err)
+	}
+	return doc
+}
+
+func findAdapter(t *testing.T, adapters []SiteAdapter, name string) SiteAdapter {
+	t.Helper()
+	for _, a := range adapters {
+		if a.Name() == name {
+			return a
+		}
+	}
+	t.Fatalf("adapter %q not loaded", name)
+	return nil
+}
+
+func TestNepalHomesRuleSet(t *testing.T) {
+	adapters, err := LoadRules(scraperRulesDir)
+	if err != nil {
+		t.Fatalf("LoadRules: %v", err)
+	}
+	adapter := findAdapter(t, adapters, "realestateinnepal.com")
+
+	doc := loadFixture(t, "testdata/nepal-homes.html")
+	props := adapter.ParseListing(doc)
+	if len(props) != 2 {
+		t.Fatalf("expected 2 properties, got %d", len(props))
+	}
+
+	first := adapter.Normalize(props[0])
+	if first.Title != "2 Bed Apartment in Baluwatar" {
+		t.Errorf("unexpected title: %q", first.Title)
+	}
+	if first.Bedrooms != 2 || first.Bathrooms != 1 {
+		t.Errorf("unexpected bed/bath: %d/%d", first.Bedrooms, first.Bathrooms)
+	}
+	if first.AreaSqFt != 850 {
+		t.Errorf("unexpected area: %v", first.AreaSqFt)
+	}
+	if first.URL != "https://www.realestateinnepal.com/listing/123" {
+		t.Errorf("unexpected url: %q", first.URL)
+	}
+	if first.Currency != "NPR" {
+		t.Errorf("unexpected currency: %q", first.Currency)
+	}
+	if first.Price != 15000000 {
+		t.Errorf("unexpected price: %v", first.Price)
+	}
+}
+
+func TestNepalHomesPagination(t *testing.T) {
+	adapters, err := LoadRules(scraperRulesDir)
+	if err != nil {
+		t.Fatalf("LoadRules: %v", err)
+	}
+	adapter := findAdapter(t, adapters, "realestateinnepal.com")
+
+	want := []string{
+		"https://www.realestateinnepal.com/search-result/?location=kathmandu&page=1",
+		"https://www.realestateinnepal.com/search-result/?location=kathmandu&page=2",
+	}
+	for page, expected := range want {
+		urls := adapter.ListURLs(page + 1)
+		if len(urls) != 1 || urls[0] != expected {
+			t.Errorf("page %d: got %v, want [%s]", page+1, urls, expected)
+		}
+	}
+
+	if urls := adapter.ListURLs(3); urls != nil {
+		t.Errorf("page 3: expected no URLs beyond configured pages, got %v", urls)
+	}
+}
+
+func TestNewRuleAdapterRejectsUnboundedPagination(t *testing.T) {
+	rules := RuleSet{
+		Name:       "test-source",
+		BaseURL:    "https://example.com",
+		SearchPath: "/search",
+		Pagination: Pagination{Template: "%s%s&page=%d"}, // Pages left unset (zero value)
+	}
+
+	if _, err := NewRuleAdapter(rules); err == nil {
+		t.Fatal("expected NewRuleAdapter to reject a template with no page limit, got nil error")
+	}
+}
+
+func TestDubaiRuleSet(t *testing.T) {
+	adapters, err := LoadRules(scraperRulesDir)
+	if err != nil {
+		t.Fatalf("LoadRules: %v", err)
+	}
+	adapter := findAdapter(t, adapters, "realestate.com.au/dubai")
+
+	doc := loadFixture(t, "testdata/dubai-realestate-com-au.html")
+	props := adapter.ParseListing(doc)
+	if len(props) != 2 {
+		t.Fatalf("expected 2 properties, got %d", len(props))
+	}
+
+	first := adapter.Normalize(props[0])
+	if first.Currency != "AED" {
+		t.Errorf("unexpected currency: %q", first.Currency)
+	}
+	if first.Price != 934000 {
+		t.Errorf("unexpected price: %v", first.Price)
+	}
+	if first.Bedrooms != 2 {
+		t.Errorf("unexpected bedrooms: %d", first.Bedrooms)
+	}
+}